@@ -0,0 +1,93 @@
+package semver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// String returns the canonical MAJOR.MINOR.PATCH[-PRE][+BUILD]
+// representation of v.
+func (v Version) String() string {
+	var b strings.Builder
+	b.WriteString(strconv.Itoa(v.Major))
+	b.WriteByte('.')
+	b.WriteString(strconv.Itoa(v.Minor))
+	b.WriteByte('.')
+	b.WriteString(strconv.Itoa(v.Patch))
+	if len(v.Pre) > 0 {
+		b.WriteByte('-')
+		b.WriteString(strings.Join(v.Pre, "."))
+	}
+	if v.Build != "" {
+		b.WriteByte('+')
+		b.WriteString(v.Build)
+	}
+	return b.String()
+}
+
+// IncMajor returns a new Version with Major incremented and Minor, Patch,
+// Pre, and Build reset.
+func (v Version) IncMajor() Version {
+	return Version{Major: v.Major + 1}
+}
+
+// IncMinor returns a new Version with Minor incremented and Patch, Pre, and
+// Build reset.
+func (v Version) IncMinor() Version {
+	return Version{Major: v.Major, Minor: v.Minor + 1}
+}
+
+// IncPatch returns a new Version with Pre and Build cleared. If v already
+// has a pre-release, Patch is left unchanged (the pre-release is considered
+// to already be "ahead of" the release it precedes); otherwise Patch is
+// incremented.
+func (v Version) IncPatch() Version {
+	patch := v.Patch
+	if len(v.Pre) == 0 {
+		patch++
+	}
+	return Version{Major: v.Major, Minor: v.Minor, Patch: patch}
+}
+
+// SetPrerelease returns a new Version with its pre-release set to pre,
+// split on ".". Build metadata is left unchanged.
+func (v Version) SetPrerelease(pre string) (Version, error) {
+	nv := v
+	if pre == "" {
+		nv.Pre = nil
+		return nv, nil
+	}
+	pos := 0
+	for _, id := range strings.Split(pre, ".") {
+		if id == "" {
+			return Version{}, &ParseError{Input: pre, Pos: pos, Reason: "pre-release identifiers must not be empty"}
+		}
+		if !isAllDigits(id) && !isValidIdentifierChars(id) {
+			return Version{}, &ParseError{Input: pre, Pos: pos, Reason: "pre-release identifier contains invalid characters"}
+		}
+		if isAllDigits(id) && len(id) > 1 && id[0] == '0' {
+			return Version{}, &ParseError{Input: pre, Pos: pos, Reason: "numeric pre-release identifier must not have leading zeros"}
+		}
+		pos += len(id) + 1
+	}
+	nv.Pre = strings.Split(pre, ".")
+	return nv, nil
+}
+
+// SetMetadata returns a new Version with its build metadata set to build.
+func (v Version) SetMetadata(build string) (Version, error) {
+	nv := v
+	if build == "" {
+		nv.Build = ""
+		return nv, nil
+	}
+	pos := 0
+	for _, id := range strings.Split(build, ".") {
+		if id == "" || !isValidIdentifierChars(id) {
+			return Version{}, &ParseError{Input: build, Pos: pos, Reason: "build metadata contains invalid characters"}
+		}
+		pos += len(id) + 1
+	}
+	nv.Build = build
+	return nv, nil
+}
@@ -0,0 +1,98 @@
+package semver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVersion_String(t *testing.T) {
+	v := Version{Major: 1, Minor: 2, Patch: 3, Pre: []string{"alpha", "1"}, Build: "build.7"}
+	if got, want := v.String(), "1.2.3-alpha.1+build.7"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	v2 := Version{Major: 1, Minor: 2, Patch: 3}
+	if got, want := v2.String(), "1.2.3"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestVersion_IncMajor(t *testing.T) {
+	v := Version{Major: 1, Minor: 2, Patch: 3, Pre: []string{"alpha"}, Build: "build"}
+	got := v.IncMajor()
+	want := Version{Major: 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("IncMajor() = %+v, want %+v", got, want)
+	}
+}
+
+func TestVersion_IncMinor(t *testing.T) {
+	v := Version{Major: 1, Minor: 2, Patch: 3, Pre: []string{"alpha"}, Build: "build"}
+	got := v.IncMinor()
+	want := Version{Major: 1, Minor: 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("IncMinor() = %+v, want %+v", got, want)
+	}
+}
+
+func TestVersion_IncPatch(t *testing.T) {
+	v := Version{Major: 1, Minor: 2, Patch: 3, Build: "build"}
+	got := v.IncPatch()
+	want := Version{Major: 1, Minor: 2, Patch: 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("IncPatch() on release = %+v, want %+v", got, want)
+	}
+
+	pre := Version{Major: 1, Minor: 2, Patch: 3, Pre: []string{"alpha"}}
+	got = pre.IncPatch()
+	want = Version{Major: 1, Minor: 2, Patch: 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("IncPatch() on pre-release = %+v, want %+v", got, want)
+	}
+}
+
+func TestVersion_SetPrerelease(t *testing.T) {
+	v := Version{Major: 1, Minor: 0, Patch: 0}
+	got, err := v.SetPrerelease("beta.2")
+	if err != nil {
+		t.Fatalf("SetPrerelease: %v", err)
+	}
+	if got.String() != "1.0.0-beta.2" {
+		t.Errorf("SetPrerelease result = %q, want %q", got.String(), "1.0.0-beta.2")
+	}
+	if v.Pre != nil {
+		t.Errorf("SetPrerelease must not mutate receiver, got Pre=%v", v.Pre)
+	}
+
+	if _, err := v.SetPrerelease("01"); err == nil {
+		t.Errorf("SetPrerelease(%q) expected error for leading zero", "01")
+	}
+}
+
+func TestVersion_SetMetadata(t *testing.T) {
+	v := Version{Major: 1, Minor: 0, Patch: 0}
+	got, err := v.SetMetadata("build.7")
+	if err != nil {
+		t.Fatalf("SetMetadata: %v", err)
+	}
+	if got.String() != "1.0.0+build.7" {
+		t.Errorf("SetMetadata result = %q, want %q", got.String(), "1.0.0+build.7")
+	}
+	if v.Build != "" {
+		t.Errorf("SetMetadata must not mutate receiver, got Build=%q", v.Build)
+	}
+
+	if _, err := v.SetMetadata("b@d"); err == nil {
+		t.Errorf("SetMetadata(%q) expected error for invalid character", "b@d")
+	}
+}
+
+func TestVersion_RoundTripThroughParse(t *testing.T) {
+	v, err := ParseStrict("1.2.3-alpha.1+build.7")
+	if err != nil {
+		t.Fatalf("ParseStrict: %v", err)
+	}
+	if got, want := v.String(), "1.2.3-alpha.1+build.7"; got != want {
+		t.Errorf("round trip = %q, want %q", got, want)
+	}
+}
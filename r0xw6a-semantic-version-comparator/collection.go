@@ -0,0 +1,53 @@
+package semver
+
+import "sort"
+
+// Collection is a slice of version strings that implements sort.Interface
+// using Compare, so it can be sorted with the standard library's sort
+// package directly.
+type Collection []string
+
+func (c Collection) Len() int           { return len(c) }
+func (c Collection) Less(i, j int) bool { return Compare(c[i], c[j]) < 0 }
+func (c Collection) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+
+// Sort sorts vs in place in ascending order, per Compare.
+func Sort(vs []string) {
+	sort.Sort(Collection(vs))
+}
+
+// SortStable sorts vs in place in ascending order, per Compare, preserving
+// the relative order of equal elements.
+func SortStable(vs []string) {
+	sort.Stable(Collection(vs))
+}
+
+// Max returns the greatest version among vs, per Compare. It returns "" if
+// vs is empty.
+func Max(vs ...string) string {
+	if len(vs) == 0 {
+		return ""
+	}
+	max := vs[0]
+	for _, v := range vs[1:] {
+		if Compare(v, max) > 0 {
+			max = v
+		}
+	}
+	return max
+}
+
+// Min returns the least version among vs, per Compare. It returns "" if vs
+// is empty.
+func Min(vs ...string) string {
+	if len(vs) == 0 {
+		return ""
+	}
+	min := vs[0]
+	for _, v := range vs[1:] {
+		if Compare(v, min) < 0 {
+			min = v
+		}
+	}
+	return min
+}
@@ -0,0 +1,40 @@
+package semver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	vs := []string{"1.2.3", "1.0.0", "2.0.0", "1.2.3-alpha"}
+	Sort(vs)
+	want := []string{"1.0.0", "1.2.3-alpha", "1.2.3", "2.0.0"}
+	if !reflect.DeepEqual(vs, want) {
+		t.Fatalf("Sort() = %v, want %v", vs, want)
+	}
+}
+
+func TestSortStable(t *testing.T) {
+	vs := []string{"1.0.0+b", "1.0.0+a", "0.9.0"}
+	SortStable(vs)
+	want := []string{"0.9.0", "1.0.0+b", "1.0.0+a"}
+	if !reflect.DeepEqual(vs, want) {
+		t.Fatalf("SortStable() = %v, want %v", vs, want)
+	}
+}
+
+func TestMaxMin(t *testing.T) {
+	vs := []string{"1.2.3", "2.0.0", "1.9.9", "0.1.0"}
+	if got := Max(vs...); got != "2.0.0" {
+		t.Errorf("Max() = %q, want %q", got, "2.0.0")
+	}
+	if got := Min(vs...); got != "0.1.0" {
+		t.Errorf("Min() = %q, want %q", got, "0.1.0")
+	}
+	if got := Max(); got != "" {
+		t.Errorf("Max() on empty = %q, want \"\"", got)
+	}
+	if got := Min(); got != "" {
+		t.Errorf("Min() on empty = %q, want \"\"", got)
+	}
+}
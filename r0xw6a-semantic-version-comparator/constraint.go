@@ -0,0 +1,364 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Constraint is a parsed version-range expression, in the style popularized
+// by Masterminds/semver and npm: comma-separated "AND" groups joined by
+// "||" for "OR".
+//
+//	NewConstraint(">=1.2.3 <2.0.0 || ^0.5.0")
+type Constraint struct {
+	// or holds one slice of andConstraints per "||"-separated group; a
+	// version satisfies the Constraint if it satisfies any group.
+	or [][]simpleConstraint
+
+	expr string
+}
+
+// simpleConstraint is a single "<op><version>" term, e.g. ">=1.2.3".
+type simpleConstraint struct {
+	op   string
+	v    Version
+	expr string
+}
+
+// NewConstraint parses expr into a Constraint. See the Constraint docs for
+// the supported grammar.
+func NewConstraint(expr string) (Constraint, error) {
+	groups := strings.Split(expr, "||")
+	or := make([][]simpleConstraint, 0, len(groups))
+
+	for _, group := range groups {
+		terms, err := splitAndTerms(group)
+		if err != nil {
+			return Constraint{}, err
+		}
+
+		and := make([]simpleConstraint, 0, len(terms))
+		for _, term := range terms {
+			cs, err := parseTerm(term)
+			if err != nil {
+				return Constraint{}, err
+			}
+			and = append(and, cs...)
+		}
+		// A group made entirely of wildcard terms ("*") legitimately
+		// yields no simpleConstraints and matches every version.
+		or = append(or, and)
+	}
+
+	return Constraint{or: or, expr: expr}, nil
+}
+
+// Check reports whether v satisfies the Constraint.
+func (c Constraint) Check(v string) bool {
+	pv := parse(v)
+	for _, and := range c.or {
+		if allSatisfy(and, pv) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate reports whether v satisfies the Constraint. When it does not,
+// the returned slice holds the first failing sub-constraint from each
+// "||"-separated group, for diagnostics.
+func (c Constraint) Validate(v string) (bool, []error) {
+	pv := parse(v)
+	var failures []error
+	for _, and := range c.or {
+		ok := true
+		for _, sc := range and {
+			if !sc.satisfies(pv) {
+				failures = append(failures, fmt.Errorf("%s does not satisfy %s", v, sc.expr))
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, failures
+}
+
+// Satisfies is a convenience helper equivalent to parsing constraint and
+// calling Check(version).
+func Satisfies(version, constraint string) (bool, error) {
+	c, err := NewConstraint(constraint)
+	if err != nil {
+		return false, err
+	}
+	return c.Check(version), nil
+}
+
+func allSatisfy(and []simpleConstraint, v Version) bool {
+	for _, sc := range and {
+		if !sc.satisfies(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (sc simpleConstraint) satisfies(v Version) bool {
+	c := compareVersions(v, sc.v)
+	switch sc.op {
+	case "=":
+		return c == 0
+	case "!=":
+		return c != 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	default:
+		return false
+	}
+}
+
+// splitAndTerms splits a comma/whitespace separated AND group into
+// individual terms, keeping hyphen ranges ("1.2.3 - 2.3.4") intact.
+func splitAndTerms(group string) ([]string, error) {
+	group = strings.TrimSpace(group)
+	if group == "" {
+		return nil, fmt.Errorf("semver: empty constraint expression")
+	}
+
+	// Normalize comma separators to spaces so "AND" is always
+	// whitespace-delimited, then re-merge hyphen ranges that got split.
+	group = strings.ReplaceAll(group, ",", " ")
+	fields := strings.Fields(group)
+
+	var terms []string
+	for i := 0; i < len(fields); i++ {
+		if i+2 < len(fields) && fields[i+1] == "-" {
+			terms = append(terms, fields[i]+" - "+fields[i+2])
+			i += 2
+			continue
+		}
+		terms = append(terms, fields[i])
+	}
+	return terms, nil
+}
+
+// parseTerm parses a single constraint term into one or more
+// simpleConstraints (tilde, caret, and hyphen ranges expand to two).
+func parseTerm(term string) ([]simpleConstraint, error) {
+	term = strings.TrimSpace(term)
+	if term == "" {
+		return nil, fmt.Errorf("semver: empty constraint term")
+	}
+
+	if idx := strings.Index(term, " - "); idx >= 0 {
+		return parseHyphenRange(term[:idx], term[idx+3:])
+	}
+
+	switch {
+	case strings.HasPrefix(term, ">="):
+		return exact(">=", term[2:])
+	case strings.HasPrefix(term, "<="):
+		return exact("<=", term[2:])
+	case strings.HasPrefix(term, "!="):
+		return exact("!=", term[2:])
+	case strings.HasPrefix(term, ">"):
+		return exact(">", term[1:])
+	case strings.HasPrefix(term, "<"):
+		return exact("<", term[1:])
+	case strings.HasPrefix(term, "="):
+		return parseBasic(term[1:], "=")
+	case strings.HasPrefix(term, "~"):
+		return parseTilde(term[1:])
+	case strings.HasPrefix(term, "^"):
+		return parseCaret(term[1:])
+	default:
+		return parseBasic(term, "=")
+	}
+}
+
+func exact(op, rest string) ([]simpleConstraint, error) {
+	return parseBasic(rest, op)
+}
+
+// parseBasic handles a plain "<op>MAJOR[.MINOR[.PATCH]]" term, expanding
+// wildcards ("x", "X", "*") and partial versions to inclusive ranges when
+// op is "=", or parsing a single exact version for comparison operators.
+func parseBasic(rest string, op string) ([]simpleConstraint, error) {
+	rest = strings.TrimSpace(rest)
+	if rest == "" || isWildcard(rest) {
+		if op != "=" {
+			return nil, fmt.Errorf("semver: wildcard not allowed with operator %q", op)
+		}
+		return nil, nil // "*" / "x" / "" matches everything: no constraint.
+	}
+
+	major, minor, patch, wildcardAt, err := splitCore(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	if op != "=" {
+		v := Version{Major: major, Minor: minor, Patch: patch}
+		return []simpleConstraint{{op: op, v: v, expr: op + rest}}, nil
+	}
+
+	// "=" with a wildcard or partial version expands to an inclusive range.
+	switch wildcardAt {
+	case 1: // "x.2.3": no major given, matches everything.
+		return nil, nil
+	case 2: // "1" or "1.x": minor unspecified, allow the whole major.
+		lo := Version{Major: major}
+		hi := Version{Major: major + 1}
+		return []simpleConstraint{
+			{op: ">=", v: lo, expr: rest},
+			{op: "<", v: hi, expr: rest},
+		}, nil
+	case 3: // "1.2" or "1.2.x": patch unspecified, allow the whole minor.
+		lo := Version{Major: major, Minor: minor}
+		hi := Version{Major: major, Minor: minor + 1}
+		return []simpleConstraint{
+			{op: ">=", v: lo, expr: rest},
+			{op: "<", v: hi, expr: rest},
+		}, nil
+	default: // fully specified
+		v := Version{Major: major, Minor: minor, Patch: patch}
+		return []simpleConstraint{{op: "=", v: v, expr: rest}}, nil
+	}
+}
+
+// parseTilde implements "~": allow patch-level changes if a minor version
+// is specified, otherwise allow minor-level changes. "~x"/"~*" (no major
+// given) matches everything.
+func parseTilde(rest string) ([]simpleConstraint, error) {
+	major, minor, patch, wildcardAt, err := splitCore(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	if wildcardAt == 1 {
+		return nil, nil
+	}
+
+	lo := Version{Major: major, Minor: minor, Patch: patch}
+	var hi Version
+	if wildcardAt == 1 || wildcardAt == 2 {
+		// Minor was not specified: allow minor-level changes.
+		hi = Version{Major: major + 1}
+	} else {
+		// Minor was specified (patch missing or fully specified):
+		// allow patch-level changes only.
+		hi = Version{Major: major, Minor: minor + 1}
+	}
+	return []simpleConstraint{
+		{op: ">=", v: lo, expr: "~" + rest},
+		{op: "<", v: hi, expr: "~" + rest},
+	}, nil
+}
+
+// parseCaret implements "^": allow changes that do not modify the
+// left-most non-zero element of MAJOR.MINOR.PATCH. A wildcard or missing
+// component widens the range the same way it does for "=" and "~": "^x"/
+// "^*" matches everything, and "^0.x"/"^0" expand to ">=0.0.0 <1.0.0"
+// rather than being pinned to the single version "0.0.0".
+func parseCaret(rest string) ([]simpleConstraint, error) {
+	major, minor, patch, wildcardAt, err := splitCore(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	if wildcardAt == 1 {
+		return nil, nil // "^x" / "^*": no major given, matches everything.
+	}
+
+	lo := Version{Major: major, Minor: minor, Patch: patch}
+	var hi Version
+	switch {
+	case major > 0:
+		hi = Version{Major: major + 1}
+	case wildcardAt == 2:
+		// "^0" / "^0.x": minor not given, widen to the next major.
+		hi = Version{Major: major + 1}
+	case minor > 0:
+		hi = Version{Major: 0, Minor: minor + 1}
+	case wildcardAt == 3:
+		// "^0.0" / "^0.0.x": patch not given, widen to the next minor.
+		hi = Version{Major: 0, Minor: minor + 1}
+	default:
+		hi = Version{Major: 0, Minor: 0, Patch: patch + 1}
+	}
+	return []simpleConstraint{
+		{op: ">=", v: lo, expr: "^" + rest},
+		{op: "<", v: hi, expr: "^" + rest},
+	}, nil
+}
+
+func parseHyphenRange(lo, hi string) ([]simpleConstraint, error) {
+	loMajor, loMinor, loPatch, _, err := splitCore(strings.TrimSpace(lo))
+	if err != nil {
+		return nil, err
+	}
+	hiMajor, hiMinor, hiPatch, hiWildcardAt, err := splitCore(strings.TrimSpace(hi))
+	if err != nil {
+		return nil, err
+	}
+
+	loV := Version{Major: loMajor, Minor: loMinor, Patch: loPatch}
+	var hiConstraint simpleConstraint
+	if hiWildcardAt == 2 || hiWildcardAt == 3 {
+		// A partial upper bound, e.g. "1.2.3 - 2.3", is exclusive of the
+		// next minor/major.
+		var hiV Version
+		if hiWildcardAt == 2 {
+			hiV = Version{Major: hiMajor + 1}
+		} else {
+			hiV = Version{Major: hiMajor, Minor: hiMinor + 1}
+		}
+		hiConstraint = simpleConstraint{op: "<", v: hiV, expr: hi}
+	} else {
+		hiV := Version{Major: hiMajor, Minor: hiMinor, Patch: hiPatch}
+		hiConstraint = simpleConstraint{op: "<=", v: hiV, expr: hi}
+	}
+
+	return []simpleConstraint{
+		{op: ">=", v: loV, expr: lo},
+		hiConstraint,
+	}, nil
+}
+
+// splitCore parses a (possibly partial or wildcarded) "MAJOR.MINOR.PATCH"
+// string. wildcardAt reports the first component that was missing or a
+// wildcard (1, 2, or 3), or 0 if the version is fully specified.
+func splitCore(s string) (major, minor, patch int, wildcardAt int, err error) {
+	parts := strings.Split(s, ".")
+	nums := [3]int{}
+	for i := 0; i < 3; i++ {
+		if i >= len(parts) || isWildcard(parts[i]) {
+			wildcardAt = i + 1
+			break
+		}
+		n, convErr := strconv.Atoi(parts[i])
+		if convErr != nil {
+			return 0, 0, 0, 0, fmt.Errorf("semver: invalid version component %q in %q", parts[i], s)
+		}
+		nums[i] = n
+	}
+	return nums[0], nums[1], nums[2], wildcardAt, nil
+}
+
+func isWildcard(s string) bool {
+	switch s {
+	case "x", "X", "*", "":
+		return true
+	default:
+		return false
+	}
+}
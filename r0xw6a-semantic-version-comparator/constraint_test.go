@@ -0,0 +1,146 @@
+package semver
+
+import "testing"
+
+func TestConstraint_ComparisonOperators(t *testing.T) {
+	cases := []struct {
+		expr, v string
+		want    bool
+	}{
+		{"=1.2.3", "1.2.3", true},
+		{"=1.2.3", "1.2.4", false},
+		{"!=1.2.3", "1.2.4", true},
+		{">1.2.3", "1.2.4", true},
+		{">1.2.3", "1.2.3", false},
+		{">=1.2.3", "1.2.3", true},
+		{"<2.0.0", "1.9.9", true},
+		{"<=2.0.0", "2.0.0", true},
+	}
+	for _, tc := range cases {
+		c, err := NewConstraint(tc.expr)
+		if err != nil {
+			t.Fatalf("NewConstraint(%q): %v", tc.expr, err)
+		}
+		if got := c.Check(tc.v); got != tc.want {
+			t.Errorf("Constraint(%q).Check(%q) = %v, want %v", tc.expr, tc.v, got, tc.want)
+		}
+	}
+}
+
+func TestConstraint_AndOr(t *testing.T) {
+	c, err := NewConstraint(">=1.2.3 <2.0.0 || ^0.5.0")
+	if err != nil {
+		t.Fatalf("NewConstraint: %v", err)
+	}
+	for _, v := range []string{"1.2.3", "1.9.9", "0.5.2"} {
+		if !c.Check(v) {
+			t.Errorf("expected %q to satisfy constraint", v)
+		}
+	}
+	for _, v := range []string{"2.0.0", "0.6.0", "1.2.2"} {
+		if c.Check(v) {
+			t.Errorf("expected %q to NOT satisfy constraint", v)
+		}
+	}
+}
+
+func TestConstraint_Tilde(t *testing.T) {
+	c, err := NewConstraint("~1.2.3")
+	if err != nil {
+		t.Fatalf("NewConstraint: %v", err)
+	}
+	if !c.Check("1.2.9") || c.Check("1.3.0") {
+		t.Fatalf("~1.2.3 should allow patch-level changes only")
+	}
+
+	c, err = NewConstraint("~1.2")
+	if err != nil {
+		t.Fatalf("NewConstraint: %v", err)
+	}
+	if !c.Check("1.2.9") || c.Check("1.3.0") {
+		t.Fatalf("~1.2 should allow patch-level changes only")
+	}
+}
+
+func TestConstraint_Caret(t *testing.T) {
+	cases := []struct {
+		expr string
+		pass []string
+		fail []string
+	}{
+		{"^1.2.3", []string{"1.2.3", "1.9.9"}, []string{"2.0.0", "1.2.2"}},
+		{"^0.2.3", []string{"0.2.3", "0.2.9"}, []string{"0.3.0", "0.2.2"}},
+		{"^0.0.3", []string{"0.0.3"}, []string{"0.0.4", "0.0.2"}},
+		{"^0.x", []string{"0.0.0", "0.5.0", "0.9.9"}, []string{"1.0.0"}},
+		{"^0", []string{"0.0.0", "0.5.0", "0.9.9"}, []string{"1.0.0"}},
+		{"^x", []string{"0.0.0", "5.6.7", "99.0.0"}, nil},
+	}
+	for _, tc := range cases {
+		c, err := NewConstraint(tc.expr)
+		if err != nil {
+			t.Fatalf("NewConstraint(%q): %v", tc.expr, err)
+		}
+		for _, v := range tc.pass {
+			if !c.Check(v) {
+				t.Errorf("%s: expected %q to satisfy", tc.expr, v)
+			}
+		}
+		for _, v := range tc.fail {
+			if c.Check(v) {
+				t.Errorf("%s: expected %q to NOT satisfy", tc.expr, v)
+			}
+		}
+	}
+}
+
+func TestConstraint_Wildcards(t *testing.T) {
+	c, err := NewConstraint("1.2.x")
+	if err != nil {
+		t.Fatalf("NewConstraint: %v", err)
+	}
+	if !c.Check("1.2.0") || !c.Check("1.2.9") || c.Check("1.3.0") {
+		t.Fatalf("1.2.x should match >=1.2.0 <1.3.0")
+	}
+
+	c, err = NewConstraint("*")
+	if err != nil {
+		t.Fatalf("NewConstraint: %v", err)
+	}
+	if !c.Check("0.0.1") || !c.Check("9.9.9") {
+		t.Fatalf("* should match everything")
+	}
+}
+
+func TestConstraint_HyphenRange(t *testing.T) {
+	c, err := NewConstraint("1.2.3 - 2.3.4")
+	if err != nil {
+		t.Fatalf("NewConstraint: %v", err)
+	}
+	if !c.Check("1.2.3") || !c.Check("2.3.4") || c.Check("2.3.5") || c.Check("1.2.2") {
+		t.Fatalf("hyphen range bounds not inclusive as expected")
+	}
+}
+
+func TestSatisfies(t *testing.T) {
+	ok, err := Satisfies("1.5.0", "^1.2.0")
+	if err != nil {
+		t.Fatalf("Satisfies: %v", err)
+	}
+	if !ok {
+		t.Fatalf("expected 1.5.0 to satisfy ^1.2.0")
+	}
+}
+
+func TestConstraint_Validate(t *testing.T) {
+	c, err := NewConstraint(">=1.2.3 <2.0.0")
+	if err != nil {
+		t.Fatalf("NewConstraint: %v", err)
+	}
+	ok, failures := c.Validate("2.5.0")
+	if ok {
+		t.Fatalf("expected 2.5.0 to fail constraint")
+	}
+	if len(failures) == 0 {
+		t.Fatalf("expected Validate to report the failing sub-constraint")
+	}
+}
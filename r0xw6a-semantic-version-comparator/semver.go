@@ -0,0 +1,169 @@
+// Package semver implements comparison of Semantic Versioning 2.0.0 version
+// strings (https://semver.org).
+package semver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Version holds the parsed components of a semantic version string.
+type Version struct {
+	Major, Minor, Patch int
+	Pre                 []string
+	Build               string
+}
+
+// parse lenient-parses s into a Version. Missing numeric components are
+// treated as zero and non-numeric characters are stripped from each core
+// component, so that malformed input never causes a panic.
+func parse(s string) Version {
+	s = strings.TrimSpace(s)
+
+	// Build metadata is separated by "+" and has no effect on precedence.
+	var build string
+	if idx := strings.Index(s, "+"); idx >= 0 {
+		build = s[idx+1:]
+		s = s[:idx]
+	}
+
+	// Pre-release is separated by the first "-" after the core version.
+	var pre []string
+	if idx := strings.Index(s, "-"); idx >= 0 {
+		if rest := s[idx+1:]; rest != "" {
+			pre = strings.Split(rest, ".")
+		}
+		s = s[:idx]
+	}
+
+	parts := strings.SplitN(s, ".", 3)
+	nums := make([]int, 3)
+	for i, p := range parts {
+		if i >= 3 {
+			break
+		}
+		nums[i] = atoiLenient(p)
+	}
+
+	return Version{
+		Major: nums[0],
+		Minor: nums[1],
+		Patch: nums[2],
+		Pre:   pre,
+		Build: build,
+	}
+}
+
+// atoiLenient parses all the digits in s as one number, skipping any other
+// characters wherever they occur (so "2a3" yields 23), and returns 0 if none
+// are found.
+func atoiLenient(s string) int {
+	n := 0
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			continue
+		}
+		n = n*10 + int(c-'0')
+	}
+	return n
+}
+
+// Parse returns the lenient parse of s as a Version. Use ParseStrict if s
+// must be validated against the full SemVer 2.0.0 grammar.
+func Parse(s string) Version {
+	return parse(s)
+}
+
+// Compare returns -1, 0, or 1 depending on whether a is less than, equal to,
+// or greater than b, following SemVer 2.0.0 precedence rules. Build metadata
+// is ignored. Malformed input is coerced rather than rejected; use
+// CompareStrict to surface parse errors instead.
+func Compare(a, b string) int {
+	va, vb := parse(a), parse(b)
+	return compareVersions(va, vb)
+}
+
+func compareVersions(va, vb Version) int {
+	if c := compareInt(va.Major, vb.Major); c != 0 {
+		return c
+	}
+	if c := compareInt(va.Minor, vb.Minor); c != 0 {
+		return c
+	}
+	if c := compareInt(va.Patch, vb.Patch); c != 0 {
+		return c
+	}
+	return comparePre(va.Pre, vb.Pre)
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// comparePre compares two pre-release identifier lists per SemVer 2.0.0
+// rule 11: a version without a pre-release outranks one with a pre-release
+// at the same core, identifiers are compared left-to-right, numeric
+// identifiers compare numerically and always have lower precedence than
+// alphanumeric identifiers, and a shorter list whose leading identifiers are
+// all equal has lower precedence than a longer one.
+func comparePre(a, b []string) int {
+	if len(a) == 0 && len(b) == 0 {
+		return 0
+	}
+	if len(a) == 0 {
+		return 1
+	}
+	if len(b) == 0 {
+		return -1
+	}
+
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	for i := 0; i < n; i++ {
+		if c := comparePreIdentifier(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	return compareInt(len(a), len(b))
+}
+
+func comparePreIdentifier(a, b string) int {
+	na, aIsNum := isNumericIdentifier(a)
+	nb, bIsNum := isNumericIdentifier(b)
+
+	switch {
+	case aIsNum && bIsNum:
+		return compareInt(na, nb)
+	case aIsNum && !bIsNum:
+		return -1
+	case !aIsNum && bIsNum:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func isNumericIdentifier(s string) (int, bool) {
+	if s == "" {
+		return 0, false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,89 @@
+package semver
+
+import "testing"
+
+func TestCompare_CanonicalPrecedenceOrdering(t *testing.T) {
+	// https://semver.org/#spec-item-11
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+	}
+
+	for i := 0; i < len(ordered)-1; i++ {
+		a, b := ordered[i], ordered[i+1]
+		if c := Compare(a, b); c != -1 {
+			t.Errorf("Compare(%q, %q) = %d, want -1", a, b, c)
+		}
+		if c := Compare(b, a); c != 1 {
+			t.Errorf("Compare(%q, %q) = %d, want 1", b, a, c)
+		}
+		if c := Compare(a, a); c != 0 {
+			t.Errorf("Compare(%q, %q) = %d, want 0", a, a, c)
+		}
+	}
+}
+
+func TestCompare_BuildMetadataIgnored(t *testing.T) {
+	if c := Compare("1.0.0+build1", "1.0.0+build2"); c != 0 {
+		t.Errorf("Compare with differing build metadata = %d, want 0", c)
+	}
+	if c := Compare("1.0.0-alpha+001", "1.0.0-alpha+002"); c != 0 {
+		t.Errorf("Compare prerelease with differing build metadata = %d, want 0", c)
+	}
+}
+
+func TestCompare_CoreVersionOrdering(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"1.2.3", "1.2.3", 0},
+		{"1.2.3", "1.2.4", -1},
+		{"1.3.0", "1.2.9", 1},
+		{"2.0.0", "1.9.9", 1},
+	}
+	for _, tc := range cases {
+		if c := Compare(tc.a, tc.b); c != tc.want {
+			t.Errorf("Compare(%q, %q) = %d, want %d", tc.a, tc.b, c, tc.want)
+		}
+	}
+}
+
+func TestParse_PreservesBuildMetadata(t *testing.T) {
+	v := Parse("1.2.3-alpha.1+build.7")
+	if v.Major != 1 || v.Minor != 2 || v.Patch != 3 {
+		t.Fatalf("unexpected core: %+v", v)
+	}
+	if len(v.Pre) != 2 || v.Pre[0] != "alpha" || v.Pre[1] != "1" {
+		t.Fatalf("unexpected pre-release: %+v", v.Pre)
+	}
+	if v.Build != "build.7" {
+		t.Fatalf("unexpected build metadata: %q", v.Build)
+	}
+}
+
+func TestCompare_MissingComponentsTreatedAsZero(t *testing.T) {
+	if c := Compare("1.2", "1.2.0"); c != 0 {
+		t.Errorf("Compare(%q, %q) = %d, want 0", "1.2", "1.2.0", c)
+	}
+	if c := Compare("1", "1.0.0"); c != 0 {
+		t.Errorf("Compare(%q, %q) = %d, want 0", "1", "1.0.0", c)
+	}
+}
+
+func TestCompare_InvalidInputsNoPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("Compare panicked: %v", r)
+		}
+	}()
+	Compare("", "")
+	Compare("not-a-version", "1.2.3")
+	Compare("1.2a.3", "1.2.3")
+}
@@ -0,0 +1,166 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseError reports why s failed ParseStrict. Pos is the byte offset into
+// Input at which the problem was detected.
+type ParseError struct {
+	Input  string
+	Pos    int
+	Reason string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("semver: invalid version %q at position %d: %s", e.Input, e.Pos, e.Reason)
+}
+
+// ParseStrict parses s as a Version, rejecting anything that does not
+// conform to the SemVer 2.0.0 grammar: no leading zeros in numeric
+// identifiers, all three core components required, no non-digit characters
+// in a core component, no empty pre-release identifiers, and build
+// metadata restricted to [0-9A-Za-z-]. Use Parse for lenient, coercing
+// parsing.
+func ParseStrict(s string) (Version, error) {
+	input := s
+
+	var build string
+	if idx := strings.IndexByte(s, '+'); idx >= 0 {
+		buildStart := idx + 1
+		build = s[buildStart:]
+		s = s[:idx]
+		if err := validateBuild(input, build, buildStart); err != nil {
+			return Version{}, err
+		}
+	}
+
+	var preRaw string
+	preStart := 0
+	hasPre := false
+	if idx := strings.IndexByte(s, '-'); idx >= 0 {
+		hasPre = true
+		preStart = idx + 1
+		preRaw = s[preStart:]
+		s = s[:idx]
+	}
+
+	coreParts := strings.Split(s, ".")
+	if len(coreParts) != 3 {
+		return Version{}, &ParseError{Input: input, Pos: 0, Reason: "version core must have exactly MAJOR.MINOR.PATCH components"}
+	}
+
+	nums := make([]int, 3)
+	pos := 0
+	for i, p := range coreParts {
+		n, err := validateNumericIdentifier(input, p, pos)
+		if err != nil {
+			return Version{}, err
+		}
+		nums[i] = n
+		pos += len(p) + 1 // +1 skips the "."
+	}
+
+	var pre []string
+	if hasPre {
+		if preRaw == "" {
+			return Version{}, &ParseError{Input: input, Pos: preStart, Reason: "pre-release must not be empty"}
+		}
+		pre = strings.Split(preRaw, ".")
+		pos = preStart
+		for _, id := range pre {
+			if id == "" {
+				return Version{}, &ParseError{Input: input, Pos: pos, Reason: "pre-release identifiers must not be empty"}
+			}
+			if isAllDigits(id) {
+				if _, err := validateNumericIdentifier(input, id, pos); err != nil {
+					return Version{}, err
+				}
+			} else if !isValidIdentifierChars(id) {
+				return Version{}, &ParseError{Input: input, Pos: pos, Reason: fmt.Sprintf("pre-release identifier %q contains invalid characters", id)}
+			}
+			pos += len(id) + 1
+		}
+	}
+
+	return Version{
+		Major: nums[0],
+		Minor: nums[1],
+		Patch: nums[2],
+		Pre:   pre,
+		Build: build,
+	}, nil
+}
+
+// CompareStrict is like Compare but returns a parse error instead of
+// silently coercing malformed input.
+func CompareStrict(a, b string) (int, error) {
+	va, err := ParseStrict(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := ParseStrict(b)
+	if err != nil {
+		return 0, err
+	}
+	return compareVersions(va, vb), nil
+}
+
+func validateNumericIdentifier(input, s string, pos int) (int, error) {
+	if s == "" {
+		return 0, &ParseError{Input: input, Pos: pos, Reason: "numeric identifier must not be empty"}
+	}
+	if !isAllDigits(s) {
+		return 0, &ParseError{Input: input, Pos: pos, Reason: fmt.Sprintf("%q is not a valid numeric identifier", s)}
+	}
+	if len(s) > 1 && s[0] == '0' {
+		return 0, &ParseError{Input: input, Pos: pos, Reason: fmt.Sprintf("numeric identifier %q must not have leading zeros", s)}
+	}
+	n := 0
+	for _, c := range s {
+		n = n*10 + int(c-'0')
+	}
+	return n, nil
+}
+
+func isAllDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isValidIdentifierChars(s string) bool {
+	for _, c := range s {
+		if !isAlphanumericOrHyphen(c) {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanumericOrHyphen(c rune) bool {
+	return c == '-' ||
+		(c >= '0' && c <= '9') ||
+		(c >= 'a' && c <= 'z') ||
+		(c >= 'A' && c <= 'Z')
+}
+
+func validateBuild(input, build string, pos int) error {
+	if build == "" {
+		return &ParseError{Input: input, Pos: pos, Reason: "build metadata must not be empty"}
+	}
+	for _, id := range strings.Split(build, ".") {
+		if id == "" || !isValidIdentifierChars(id) {
+			return &ParseError{Input: input, Pos: pos, Reason: fmt.Sprintf("build metadata identifier %q contains invalid characters", id)}
+		}
+		pos += len(id) + 1
+	}
+	return nil
+}
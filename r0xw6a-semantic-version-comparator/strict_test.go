@@ -0,0 +1,81 @@
+package semver
+
+import "testing"
+
+func TestParseStrict_Valid(t *testing.T) {
+	cases := []string{
+		"1.2.3",
+		"0.0.0",
+		"1.2.3-alpha",
+		"1.2.3-alpha.1",
+		"1.2.3+build.7",
+		"1.2.3-beta+exp.sha.5114f85",
+	}
+	for _, s := range cases {
+		if _, err := ParseStrict(s); err != nil {
+			t.Errorf("ParseStrict(%q) returned unexpected error: %v", s, err)
+		}
+	}
+}
+
+func TestParseStrict_Rejects(t *testing.T) {
+	cases := []string{
+		"01.2.3",     // leading zero
+		"1.2",        // missing component
+		"1.2a.3",     // non-digit in core component
+		"1.0.0-",     // empty pre-release
+		"1.0.0+b@d",  // invalid build metadata character
+		"1.0.0-a..b", // empty pre-release identifier
+	}
+	for _, s := range cases {
+		_, err := ParseStrict(s)
+		if err == nil {
+			t.Errorf("ParseStrict(%q) expected error, got none", s)
+			continue
+		}
+		if _, ok := err.(*ParseError); !ok {
+			t.Errorf("ParseStrict(%q) error type = %T, want *ParseError", s, err)
+		}
+	}
+}
+
+func TestParseStrict_ReportsAccuratePos(t *testing.T) {
+	cases := []struct {
+		s    string
+		want int
+	}{
+		{"01.2.3", 0},   // leading zero in MAJOR, at the start
+		{"1.2a.3", 2},   // non-digit MINOR starts right after "1."
+		{"1.2.3-01", 6}, // leading zero in the pre-release, after "1.2.3-"
+	}
+	for _, tc := range cases {
+		_, err := ParseStrict(tc.s)
+		pe, ok := err.(*ParseError)
+		if !ok {
+			t.Fatalf("ParseStrict(%q) error type = %T, want *ParseError", tc.s, err)
+		}
+		if pe.Pos != tc.want {
+			t.Errorf("ParseStrict(%q) Pos = %d, want %d", tc.s, pe.Pos, tc.want)
+		}
+	}
+}
+
+func TestCompareStrict(t *testing.T) {
+	c, err := CompareStrict("1.2.3", "1.2.4")
+	if err != nil {
+		t.Fatalf("CompareStrict: %v", err)
+	}
+	if c != -1 {
+		t.Errorf("CompareStrict(1.2.3, 1.2.4) = %d, want -1", c)
+	}
+
+	if _, err := CompareStrict("1.2", "1.2.0"); err == nil {
+		t.Errorf("CompareStrict expected error for malformed input")
+	}
+}
+
+func TestCompare_StillLenient(t *testing.T) {
+	if c := Compare("1.2", "1.2.0"); c != 0 {
+		t.Errorf("Compare should remain lenient, got %d", c)
+	}
+}